@@ -21,7 +21,7 @@ var config = driver.Config{
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &Pan115{}
 	})
 }
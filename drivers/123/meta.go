@@ -22,7 +22,7 @@ var config = driver.Config{
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		// 新增默认选项 要在RegisterDriver初始化设置 才会对正在使用的用户生效
 		return &Pan123{
 			Addition: Addition{
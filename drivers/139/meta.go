@@ -24,7 +24,7 @@ var config = driver.Config{
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		d := &Yun139{}
 		d.ProxyRange = true
 		return d
@@ -30,7 +30,7 @@ var config = driver.Config{
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &Alias{
 			Addition: Addition{
 				ProtectSameName: true,
@@ -24,7 +24,7 @@ We recommend using the official driver AliyundriveOpen.`,
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &AliDrive{}
 	})
 }
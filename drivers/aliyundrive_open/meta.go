@@ -31,7 +31,7 @@ var config = driver.Config{
 var API_URL = "https://openapi.alipan.com"
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &AliyundriveOpen{}
 	})
 }
@@ -27,7 +27,7 @@ type Conf struct {
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &ChaoXing{
 			config: driver.Config{
 				Name:              "ChaoXingGroupDrive",
@@ -28,7 +28,7 @@ var config = driver.Config{
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &Chunk{
 			Addition: Addition{
 				ChunkPrefix:    "[openlist_chunk]",
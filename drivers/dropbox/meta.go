@@ -22,7 +22,7 @@ var config = driver.Config{
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &Dropbox{
 			base:        "https://api.dropboxapi.com",
 			contentBase: "https://content.dropboxapi.com",
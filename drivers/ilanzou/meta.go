@@ -26,7 +26,7 @@ type Conf struct {
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &ILanZou{
 			config: driver.Config{
 				Name:              "ILanZou",
@@ -45,7 +45,7 @@ func init() {
 			},
 		}
 	})
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &ILanZou{
 			config: driver.Config{
 				Name:              "FeijiPan",
@@ -27,7 +27,7 @@ var config = driver.Config{
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &Local{
 			directoryMap: DirectoryMap{},
 		}
@@ -47,7 +47,7 @@ var config = driver.Config{
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &Mediafire{
 			appBase:         "https://app.mediafire.com",
 			apiBase:         "https://www.mediafire.com/api/1.5",
@@ -40,7 +40,7 @@ var config = driver.Config{
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &ProtonDrive{
 			Addition: Addition{
 				UseReusableLogin: true,
@@ -24,7 +24,7 @@ type Conf struct {
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &QuarkOpen{
 			config: driver.Config{
 				Name:              "QuarkOpen",
@@ -23,7 +23,7 @@ type Conf struct {
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &QuarkOrUC{
 			config: driver.Config{
 				Name:              "Quark",
@@ -38,7 +38,7 @@ func init() {
 			},
 		}
 	})
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &QuarkOrUC{
 			config: driver.Config{
 				Name:              "UC",
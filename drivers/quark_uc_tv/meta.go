@@ -30,7 +30,7 @@ type Conf struct {
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &QuarkUCTV{
 			config: driver.Config{
 				Name:              "QuarkTV",
@@ -48,7 +48,7 @@ func init() {
 			},
 		}
 	})
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &QuarkUCTV{
 			config: driver.Config{
 				Name:              "UCTV",
@@ -24,7 +24,7 @@ type Addition struct {
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &S3{
 			config: driver.Config{
 				Name:        "S3",
@@ -34,7 +34,7 @@ func init() {
 			},
 		}
 	})
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &S3{
 			config: driver.Config{
 				Name:        "Doge",
@@ -25,7 +25,7 @@ var config = driver.Config{
 }
 
 func init() {
-	op.RegisterDriver(func() driver.Driver {
+	op.MustRegisterDriver(func() driver.Driver {
 		return &Strm{
 			Addition: Addition{
 				EncodePath: true,
@@ -19,6 +19,9 @@ var (
 	StreamIncomplete   = errors.New("upload/download stream incomplete, possible network issue")
 	StreamPeekFail     = errors.New("StreamPeekFail")
 
+	DriverNotFound          = errors.New("driver not found")
+	DriverAlreadyRegistered = errors.New("driver already registered")
+
 	UnknownArchiveFormat      = errors.New("unknown archive format")
 	WrongArchivePassword      = errors.New("wrong archive password")
 	DriverExtractNotSupported = errors.New("driver extraction not supported")
@@ -3,35 +3,72 @@ package op
 import (
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/OpenListTeam/OpenList/v4/internal/conf"
 
 	"github.com/OpenListTeam/OpenList/v4/internal/driver"
-	"github.com/pkg/errors"
+	"github.com/OpenListTeam/OpenList/v4/internal/errs"
 )
 
 type DriverConstructor func() driver.Driver
 
 var driverMap = map[string]DriverConstructor{}
 var driverInfoMap = map[string]driver.Info{}
+var driverMu sync.RWMutex
 
-func RegisterDriver(driver DriverConstructor) {
+// RegisterDriver returns errs.DriverAlreadyRegistered if the name is taken,
+// the existing driver is kept in that case
+func RegisterDriver(driver DriverConstructor) error {
 	// log.Infof("register driver: [%s]", config.Name)
 	tempDriver := driver()
 	tempConfig := tempDriver.Config()
+	driverMu.Lock()
+	if _, ok := driverMap[tempConfig.Name]; ok {
+		driverMu.Unlock()
+		return errs.NewErr(errs.DriverAlreadyRegistered, "driver: %s", tempConfig.Name)
+	}
 	registerDriverItems(tempConfig, tempDriver.GetAddition())
 	driverMap[tempConfig.Name] = driver
+	driverMu.Unlock()
+	callDriverHooks("register", tempConfig.Name)
+	return nil
+}
+
+// MustRegisterDriver is used by the built-in drivers in init,
+// it panics if the driver name is already registered
+func MustRegisterDriver(driver DriverConstructor) {
+	if err := RegisterDriver(driver); err != nil {
+		panic(err)
+	}
+}
+
+// UnregisterDriver remove the driver from both driverMap and driverInfoMap,
+// storages that already use it are not affected
+func UnregisterDriver(name string) {
+	driverMu.Lock()
+	_, ok := driverMap[name]
+	delete(driverMap, name)
+	delete(driverInfoMap, name)
+	driverMu.Unlock()
+	if ok {
+		callDriverHooks("unregister", name)
+	}
 }
 
 func GetDriver(name string) (DriverConstructor, error) {
+	driverMu.RLock()
+	defer driverMu.RUnlock()
 	n, ok := driverMap[name]
 	if !ok {
-		return nil, errors.Errorf("no driver named: %s", name)
+		return nil, errs.NewErr(errs.DriverNotFound, "driver: %s", name)
 	}
 	return n, nil
 }
 
 func GetDriverNames() []string {
+	driverMu.RLock()
+	defer driverMu.RUnlock()
 	var driverNames []string
 	for k := range driverInfoMap {
 		driverNames = append(driverNames, k)
@@ -40,7 +77,13 @@ func GetDriverNames() []string {
 }
 
 func GetDriverInfoMap() map[string]driver.Info {
-	return driverInfoMap
+	driverMu.RLock()
+	defer driverMu.RUnlock()
+	infoMap := make(map[string]driver.Info, len(driverInfoMap))
+	for k, v := range driverInfoMap {
+		infoMap[k] = v
+	}
+	return infoMap
 }
 
 func registerDriverItems(config driver.Config, addition driver.Additional) {
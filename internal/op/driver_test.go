@@ -1,12 +1,28 @@
 package op_test
 
 import (
+	"errors"
+	"slices"
 	"testing"
 
 	_ "github.com/OpenListTeam/OpenList/v4/drivers"
+	"github.com/OpenListTeam/OpenList/v4/drivers/local"
+	"github.com/OpenListTeam/OpenList/v4/internal/driver"
+	"github.com/OpenListTeam/OpenList/v4/internal/errs"
 	"github.com/OpenListTeam/OpenList/v4/internal/op"
+	"github.com/OpenListTeam/OpenList/v4/pkg/utils"
 )
 
+// testDriver reuses Local under another name, so it can be registered
+// and unregistered without touching the built-in drivers
+type testDriver struct {
+	local.Local
+}
+
+func (d *testDriver) Config() driver.Config {
+	return driver.Config{Name: "TestDriver"}
+}
+
 func TestDriverItemsMap(t *testing.T) {
 	itemsMap := op.GetDriverInfoMap()
 	if len(itemsMap) != 0 {
@@ -15,3 +31,87 @@ func TestDriverItemsMap(t *testing.T) {
 		t.Errorf("expected driverInfoMap not empty, but got empty")
 	}
 }
+
+func TestDriverHook(t *testing.T) {
+	var events []string
+	// hooks can't be removed, stop recording once the test is done
+	recording := true
+	t.Cleanup(func() {
+		recording = false
+	})
+	op.RegisterDriverHook(func(typ string, name string) {
+		if recording && name == "TestDriver" {
+			events = append(events, typ)
+		}
+	})
+	err := op.RegisterDriver(func() driver.Driver {
+		return &testDriver{}
+	})
+	if err != nil {
+		t.Fatalf("failed to register driver: %+v", err)
+	}
+	op.UnregisterDriver("TestDriver")
+	// unregister an unknown driver should not fire hooks
+	op.UnregisterDriver("TestDriver")
+	expected := []string{"register", "unregister"}
+	if !utils.SliceEqual(events, expected) {
+		t.Errorf("expected: %+v, got: %+v", expected, events)
+	}
+}
+
+func TestDriverErrors(t *testing.T) {
+	constructor := func() driver.Driver {
+		return &testDriver{}
+	}
+	if err := op.RegisterDriver(constructor); err != nil {
+		t.Fatalf("failed to register driver: %+v", err)
+	}
+	defer op.UnregisterDriver("TestDriver")
+	if err := op.RegisterDriver(constructor); !errors.Is(err, errs.DriverAlreadyRegistered) {
+		t.Errorf("expected %s, got: %+v", errs.DriverAlreadyRegistered, err)
+	}
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("expected MustRegisterDriver to panic on a registered name")
+			}
+		}()
+		op.MustRegisterDriver(constructor)
+	}()
+	if _, err := op.GetDriver("None"); !errors.Is(err, errs.DriverNotFound) {
+		t.Errorf("expected %s, got: %+v", errs.DriverNotFound, err)
+	}
+}
+
+func TestUnregisterDriver(t *testing.T) {
+	err := op.RegisterDriver(func() driver.Driver {
+		return &testDriver{}
+	})
+	if err != nil {
+		t.Fatalf("failed to register driver: %+v", err)
+	}
+	if _, err := op.GetDriver("TestDriver"); err != nil {
+		t.Fatalf("expected TestDriver registered, got: %+v", err)
+	}
+	if _, ok := op.GetDriverInfoMap()["TestDriver"]; !ok {
+		t.Fatalf("expected TestDriver in driverInfoMap")
+	}
+	op.UnregisterDriver("TestDriver")
+	if _, err := op.GetDriver("TestDriver"); !errors.Is(err, errs.DriverNotFound) {
+		t.Errorf("expected %s, got: %+v", errs.DriverNotFound, err)
+	}
+	if _, ok := op.GetDriverInfoMap()["TestDriver"]; ok {
+		t.Errorf("expected TestDriver removed from driverInfoMap")
+	}
+	if slices.Contains(op.GetDriverNames(), "TestDriver") {
+		t.Errorf("expected TestDriver removed from driver names")
+	}
+	// the name is free again after unregister
+	err = op.RegisterDriver(func() driver.Driver {
+		return &testDriver{}
+	})
+	if err != nil {
+		t.Errorf("failed to register driver again: %+v", err)
+	}
+	op.UnregisterDriver("TestDriver")
+}
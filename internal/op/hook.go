@@ -109,3 +109,20 @@ func callStorageHooks(typ string, storage driver.Driver) {
 func RegisterStorageHook(hook StorageHook) {
 	storageHooks = append(storageHooks, hook)
 }
+
+// Driver
+type DriverHook func(typ string, name string)
+
+var driverHooks = make([]DriverHook, 0)
+
+func callDriverHooks(typ string, name string) {
+	for _, hook := range driverHooks {
+		hook(typ, name)
+	}
+}
+
+// RegisterDriverHook hooks are called after the driver maps are updated,
+// typ is either "register" or "unregister"
+func RegisterDriverHook(hook DriverHook) {
+	driverHooks = append(driverHooks, hook)
+}
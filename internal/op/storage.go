@@ -30,6 +30,21 @@ func GetAllStorages() []driver.Driver {
 	return storagesMap.Values()
 }
 
+// StoragesUsingDriver get all mounted storages that use the driver
+func StoragesUsingDriver(driverName string) []model.Storage {
+	storages := make([]model.Storage, 0)
+	storagesMap.Range(func(_ string, d driver.Driver) bool {
+		if s := d.GetStorage(); s.Driver == driverName {
+			storages = append(storages, *s)
+		}
+		return true
+	})
+	sort.Slice(storages, func(i, j int) bool {
+		return storages[i].MountPath < storages[j].MountPath
+	})
+	return storages
+}
+
 func HasStorage(mountPath string) bool {
 	return storagesMap.Has(utils.FixAndCleanPath(mountPath))
 }
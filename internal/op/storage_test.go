@@ -2,6 +2,8 @@ package op_test
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/OpenListTeam/OpenList/v4/internal/conf"
@@ -71,6 +73,41 @@ func TestGetBalancedStorage(t *testing.T) {
 	}
 }
 
+func TestStoragesUsingDriver(t *testing.T) {
+	for _, mountPath := range []string{"/using_driver/b", "/using_driver/a"} {
+		id, err := op.CreateStorage(context.Background(), model.Storage{Driver: "Local", MountPath: mountPath, Addition: `{"root_folder_path":"."}`})
+		if err != nil {
+			t.Fatalf("failed to create storage: %+v", err)
+		}
+		t.Cleanup(func() {
+			if err := op.DeleteStorageById(context.Background(), id); err != nil {
+				t.Errorf("failed to delete storage: %+v", err)
+			}
+		})
+	}
+	storages := op.StoragesUsingDriver("Local")
+	var mountPaths, usingDriver []string
+	for _, storage := range storages {
+		if storage.Driver != "Local" {
+			t.Errorf("expected driver Local, got: %s", storage.Driver)
+		}
+		mountPaths = append(mountPaths, storage.MountPath)
+		if strings.HasPrefix(storage.MountPath, "/using_driver/") {
+			usingDriver = append(usingDriver, storage.MountPath)
+		}
+	}
+	if !slices.IsSorted(mountPaths) {
+		t.Errorf("expected storages sorted by mount path, got: %+v", mountPaths)
+	}
+	expected := []string{"/using_driver/a", "/using_driver/b"}
+	if !utils.SliceEqual(usingDriver, expected) {
+		t.Errorf("expected: %+v, got: %+v", expected, usingDriver)
+	}
+	if storages := op.StoragesUsingDriver("None"); storages == nil || len(storages) != 0 {
+		t.Errorf("expected empty storages using None, got: %+v", storages)
+	}
+}
+
 func setupStorages(t *testing.T) {
 	var storages = []model.Storage{
 		{Driver: "Local", MountPath: "/a/b", Order: 0, Addition: `{"root_folder_path":"."}`},
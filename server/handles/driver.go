@@ -26,3 +26,12 @@ func GetDriverInfo(c *gin.Context) {
 	}
 	common.SuccessResp(c, items)
 }
+
+func ListDriverStorages(c *gin.Context) {
+	driverName := c.Query("driver")
+	if _, ok := op.GetDriverInfoMap()[driverName]; !ok {
+		common.ErrorStrResp(c, fmt.Sprintf("driver [%s] not found", driverName), 404)
+		return
+	}
+	common.SuccessResp(c, op.StoragesUsingDriver(driverName))
+}